@@ -0,0 +1,86 @@
+package ksuid
+
+import "fmt"
+
+const (
+	// The length of a KSUID when Crockford base32 encoded
+	base32EncodedLength = 32
+
+	base32Alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+// Maps a Crockford base32 digit byte, upper or lower case, to its
+// 5-bit value; 0xFF for anything else.
+var base32Decode [256]byte
+
+func init() {
+	for i := range base32Decode {
+		base32Decode[i] = 0xFF
+	}
+	for i := 0; i < len(base32Alphabet); i++ {
+		c := base32Alphabet[i]
+		base32Decode[c] = byte(i)
+		if c >= 'A' && c <= 'Z' {
+			base32Decode[c+('a'-'A')] = byte(i)
+		}
+	}
+}
+
+// AppendBase32 appends the Crockford base32 representation of id to
+// dst, returning the extended slice.
+func AppendBase32(dst []byte, id KSUID) []byte {
+	var out [base32EncodedLength]byte
+
+	var bitBuf uint64
+	bits := uint(0)
+	o := 0
+
+	for _, b := range id {
+		bitBuf = bitBuf<<8 | uint64(b)
+		bits += 8
+
+		for bits >= 5 {
+			bits -= 5
+			out[o] = base32Alphabet[(bitBuf>>bits)&0x1F]
+			o++
+		}
+	}
+
+	return append(dst, out[:]...)
+}
+
+// ParseBase32 decodes a Crockford base32-encoded KSUID, as produced by
+// AppendBase32 or KSUID.StringBase32. Decoding is case-insensitive.
+func ParseBase32(s string) (KSUID, error) {
+	if len(s) != base32EncodedLength {
+		return Nil, fmt.Errorf("Valid base32-encoded KSUIDs are %v characters", base32EncodedLength)
+	}
+
+	var buf [byteLength]byte
+	var bitBuf uint64
+	bits := uint(0)
+	o := 0
+
+	for i := 0; i < len(s); i++ {
+		v := base32Decode[s[i]]
+		if v == 0xFF {
+			return Nil, fmt.Errorf("Invalid base32 character %q", s[i])
+		}
+
+		bitBuf = bitBuf<<5 | uint64(v)
+		bits += 5
+
+		if bits >= 8 {
+			bits -= 8
+			buf[o] = byte(bitBuf >> bits)
+			o++
+		}
+	}
+
+	return FromBytes(buf[:])
+}
+
+// StringBase32 returns the Crockford base32 representation of i.
+func (i KSUID) StringBase32() string {
+	return string(AppendBase32(make([]byte, 0, base32EncodedLength), i))
+}
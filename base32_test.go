@@ -0,0 +1,57 @@
+package ksuid
+
+import "testing"
+
+func TestBase32RoundTrip(t *testing.T) {
+	id := New()
+
+	s := id.StringBase32()
+	if len(s) != base32EncodedLength {
+		t.Fatalf("StringBase32 length = %v, want %v", len(s), base32EncodedLength)
+	}
+
+	decoded, err := ParseBase32(s)
+	if err != nil {
+		t.Fatalf("ParseBase32: %v", err)
+	}
+	if decoded != id {
+		t.Fatalf("ParseBase32(StringBase32()) = %v, want %v", decoded, id)
+	}
+}
+
+func TestBase32CaseInsensitive(t *testing.T) {
+	id := New()
+	s := id.StringBase32()
+
+	lower := make([]byte, len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		lower[i] = c
+	}
+
+	decoded, err := ParseBase32(string(lower))
+	if err != nil {
+		t.Fatalf("ParseBase32(lowercase): %v", err)
+	}
+	if decoded != id {
+		t.Fatalf("ParseBase32(lowercase) = %v, want %v", decoded, id)
+	}
+}
+
+func TestBase32InvalidLength(t *testing.T) {
+	if _, err := ParseBase32("short"); err == nil {
+		t.Fatalf("expected error for wrong-length input")
+	}
+}
+
+func TestBase32InvalidCharacter(t *testing.T) {
+	s := Nil.StringBase32()
+	bad := "I" + s[1:]
+
+	if _, err := ParseBase32(bad); err == nil {
+		t.Fatalf("expected error for invalid base32 character")
+	}
+}
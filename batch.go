@@ -0,0 +1,58 @@
+package ksuid
+
+import (
+	"io"
+	"sort"
+	"time"
+)
+
+// NewBatch is a convenience wrapper around AppendBatch that allocates a
+// fresh slice of n KSUIDs.
+func NewBatch(n int) ([]KSUID, error) {
+	return AppendBatch(make([]KSUID, 0, n), n)
+}
+
+// AppendBatch generates n KSUIDs sharing a single timestamp and appends
+// them to dst, returning the extended slice. It performs a single
+// io.ReadFull of n*16 bytes from the random source rather than n
+// separate reads.
+func AppendBatch(dst []KSUID, n int) ([]KSUID, error) {
+	if n <= 0 {
+		return dst, nil
+	}
+
+	now := time.Now()
+
+	buf := make([]byte, n*payloadLengthInBytes)
+	if _, err := io.ReadFull(rander, buf); err != nil {
+		return dst, err
+	}
+
+	for i := 0; i < n; i++ {
+		payload := buf[i*payloadLengthInBytes : (i+1)*payloadLengthInBytes]
+		id, err := FromParts(now, payload)
+		if err != nil {
+			return dst, err
+		}
+		dst = append(dst, id)
+	}
+
+	return dst, nil
+}
+
+// NewBatchMonotonic is identical to NewBatch except that the returned
+// KSUIDs are sorted into Compare order before being returned. Since
+// every ID in the batch shares a timestamp, sorting the batch is
+// equivalent to sorting the payloads.
+func NewBatchMonotonic(n int) ([]KSUID, error) {
+	batch, err := NewBatch(n)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(batch, func(i, j int) bool {
+		return Compare(batch[i], batch[j]) < 0
+	})
+
+	return batch, nil
+}
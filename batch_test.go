@@ -0,0 +1,49 @@
+package ksuid
+
+import "testing"
+
+func TestNewBatchCount(t *testing.T) {
+	batch, err := NewBatch(10)
+	if err != nil {
+		t.Fatalf("NewBatch: %v", err)
+	}
+	if len(batch) != 10 {
+		t.Fatalf("len(batch) = %v, want 10", len(batch))
+	}
+
+	seen := make(map[KSUID]bool, len(batch))
+	for _, id := range batch {
+		if seen[id] {
+			t.Fatalf("duplicate ID in batch: %v", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestAppendBatchAppends(t *testing.T) {
+	existing := []KSUID{New()}
+
+	batch, err := AppendBatch(existing, 5)
+	if err != nil {
+		t.Fatalf("AppendBatch: %v", err)
+	}
+	if len(batch) != 6 {
+		t.Fatalf("len(batch) = %v, want 6", len(batch))
+	}
+	if batch[0] != existing[0] {
+		t.Fatalf("AppendBatch did not preserve the existing element")
+	}
+}
+
+func TestNewBatchMonotonicIsSorted(t *testing.T) {
+	batch, err := NewBatchMonotonic(50)
+	if err != nil {
+		t.Fatalf("NewBatchMonotonic: %v", err)
+	}
+
+	for i := 1; i < len(batch); i++ {
+		if Compare(batch[i-1], batch[i]) > 0 {
+			t.Fatalf("batch not sorted at index %v: %v > %v", i, batch[i-1], batch[i])
+		}
+	}
+}
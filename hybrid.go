@@ -0,0 +1,108 @@
+package ksuid
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"io"
+	"net"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	hybridMachineID = readMachineID()
+	hybridPid       = uint16(os.Getpid())
+	hybridCounter   = randomUint32()
+)
+
+// readMachineID derives a 3-byte machine identifier from the first
+// non-loopback MAC address, falling back to a hostname hash, then random.
+func readMachineID() [3]byte {
+	var id [3]byte
+
+	if ifaces, err := net.Interfaces(); err == nil {
+		for _, iface := range ifaces {
+			if iface.Flags&net.FlagLoopback != 0 {
+				continue
+			}
+			if len(iface.HardwareAddr) >= 3 {
+				copy(id[:], iface.HardwareAddr[len(iface.HardwareAddr)-3:])
+				return id
+			}
+		}
+	}
+
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		h := fnv.New32a()
+		h.Write([]byte(hostname))
+		sum := h.Sum32()
+		id[0], id[1], id[2] = byte(sum>>16), byte(sum>>8), byte(sum)
+		return id
+	}
+
+	io.ReadFull(rander, id[:])
+	return id
+}
+
+func randomUint32() uint32 {
+	var b [4]byte
+	io.ReadFull(rander, b[:])
+	return binary.BigEndian.Uint32(b[:])
+}
+
+// HybridKSUID is a view over a KSUID whose payload was produced by
+// NewHybrid: 3 bytes of machine ID, 2 bytes of process ID, 3 bytes of a
+// per-process counter, and 8 bytes of random data, in the style of
+// MongoDB's ObjectID or rs/xid. The KSUIDs NewHybrid returns remain
+// fully valid for Parse, Compare and every other function in this
+// package; AsHybrid only changes how the payload is read, and KSUID
+// converts a HybridKSUID back.
+type HybridKSUID KSUID
+
+// NewHybrid generates a new KSUID whose payload is structured for
+// coordination-free uniqueness across hosts and processes rather than
+// relying on 16 bytes of entropy alone.
+func NewHybrid() (KSUID, error) {
+	var payload [payloadLengthInBytes]byte
+
+	copy(payload[0:3], hybridMachineID[:])
+	binary.BigEndian.PutUint16(payload[3:5], hybridPid)
+
+	counter := atomic.AddUint32(&hybridCounter, 1) & 0xFFFFFF
+	payload[5] = byte(counter >> 16)
+	payload[6] = byte(counter >> 8)
+	payload[7] = byte(counter)
+
+	if _, err := io.ReadFull(rander, payload[8:]); err != nil {
+		return Nil, err
+	}
+
+	return FromParts(time.Now(), payload[:])
+}
+
+// AsHybrid reinterprets i's payload as one produced by NewHybrid.
+func (i KSUID) AsHybrid() HybridKSUID {
+	return HybridKSUID(i)
+}
+
+// KSUID returns the plain KSUID view of h.
+func (h HybridKSUID) KSUID() KSUID {
+	return KSUID(h)
+}
+
+// MachineID returns the 3-byte machine identifier portion of the payload.
+func (h HybridKSUID) MachineID() []byte {
+	return h[timestampLengthInBytes : timestampLengthInBytes+3]
+}
+
+// Pid returns the process ID portion of the payload.
+func (h HybridKSUID) Pid() uint16 {
+	return binary.BigEndian.Uint16(h[timestampLengthInBytes+3 : timestampLengthInBytes+5])
+}
+
+// Counter returns the per-process counter portion of the payload.
+func (h HybridKSUID) Counter() uint32 {
+	b := h[timestampLengthInBytes+5 : timestampLengthInBytes+8]
+	return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+}
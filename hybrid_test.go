@@ -0,0 +1,37 @@
+package ksuid
+
+import "testing"
+
+func TestNewHybridAccessors(t *testing.T) {
+	id, err := NewHybrid()
+	if err != nil {
+		t.Fatalf("NewHybrid: %v", err)
+	}
+
+	h := id.AsHybrid()
+
+	if string(h.MachineID()) != string(hybridMachineID[:]) {
+		t.Fatalf("MachineID = %v, want %v", h.MachineID(), hybridMachineID[:])
+	}
+	if h.Pid() != hybridPid {
+		t.Fatalf("Pid = %v, want %v", h.Pid(), hybridPid)
+	}
+	if h.KSUID() != id {
+		t.Fatalf("KSUID() = %v, want %v", h.KSUID(), id)
+	}
+}
+
+func TestNewHybridCounterIncrements(t *testing.T) {
+	first, err := NewHybrid()
+	if err != nil {
+		t.Fatalf("NewHybrid: %v", err)
+	}
+	second, err := NewHybrid()
+	if err != nil {
+		t.Fatalf("NewHybrid: %v", err)
+	}
+
+	if second.AsHybrid().Counter() <= first.AsHybrid().Counter() {
+		t.Fatalf("Counter did not increase: %v then %v", first.AsHybrid().Counter(), second.AsHybrid().Counter())
+	}
+}
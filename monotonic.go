@@ -0,0 +1,117 @@
+package ksuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// maxIncr bounds the random increment applied to the previous payload
+// when a MonotonicGenerator sees a timestamp it has already used.
+const maxIncr = 1 << 24
+
+// MonotonicGenerator produces KSUIDs that are strictly increasing in
+// Compare order even across repeated calls within the same timestamp
+// tick. The zero value is ready to use and has one-second resolution,
+// like the generator NewMonotonicGenerator returns; use NewMonotonicMS
+// for millisecond precision.
+type MonotonicGenerator struct {
+	mu      sync.Mutex
+	started bool
+	lastTS  uint32
+	lastPay [payloadLengthInBytes]byte
+	ms      bool
+}
+
+// NewMonotonicGenerator returns a MonotonicGenerator using KSUID's
+// native one-second timestamp resolution.
+func NewMonotonicGenerator() *MonotonicGenerator {
+	return &MonotonicGenerator{}
+}
+
+// NewMonotonicMS returns a MonotonicGenerator that steals the first two
+// bytes of the payload to encode the millisecond-within-second as a
+// big-endian uint16, cutting the same-tick collision window by 1000x.
+func NewMonotonicMS() *MonotonicGenerator {
+	return &MonotonicGenerator{ms: true}
+}
+
+// Next returns the next KSUID from the generator. It is safe to call
+// concurrently from multiple goroutines.
+func (g *MonotonicGenerator) Next() (KSUID, error) {
+	return g.next(time.Now())
+}
+
+func (g *MonotonicGenerator) next(t time.Time) (KSUID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ts := timeToCorrectedUTCTimestamp(t)
+	ms := uint16(t.Nanosecond() / int(time.Millisecond))
+
+	var pay [payloadLengthInBytes]byte
+	if !g.started || ts > g.lastTS ||
+		(g.ms && ts == g.lastTS && ms > binary.BigEndian.Uint16(g.lastPay[:2])) {
+		if _, err := io.ReadFull(rander, pay[:]); err != nil {
+			return Nil, err
+		}
+		if g.ms {
+			binary.BigEndian.PutUint16(pay[:2], ms)
+		}
+	} else {
+		ts = g.lastTS
+		var err error
+		// incrPayload's carry alone keeps IDs ordered within the tick,
+		// including under backward clock skew; re-stamping pay[:2] from
+		// the current (possibly skewed) wall clock would clobber that.
+		if pay, err = incrPayload(g.lastPay); err != nil {
+			return Nil, err
+		}
+	}
+
+	g.started = true
+	g.lastTS = ts
+	g.lastPay = pay
+
+	var id KSUID
+	binary.BigEndian.PutUint32(id[:timestampLengthInBytes], ts)
+	copy(id[timestampLengthInBytes:], pay[:])
+	return id, nil
+}
+
+// Reset clears the generator's state so that the next call to Next
+// draws a fresh random payload and takes its timestamp from its own
+// clock reading, as on a new generator. t is recorded as the last
+// timestamp, but that next call does not compare against it. It is
+// intended for deterministic tests.
+func (g *MonotonicGenerator) Reset(t time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.started = false
+	g.lastTS = timeToCorrectedUTCTimestamp(t)
+	g.lastPay = [payloadLengthInBytes]byte{}
+}
+
+// incrPayload treats p as a big-endian 128-bit integer and adds a
+// random value in [1, maxIncr], erroring on overflow.
+func incrPayload(p [payloadLengthInBytes]byte) ([payloadLengthInBytes]byte, error) {
+	var b [4]byte
+	if _, err := io.ReadFull(rander, b[:]); err != nil {
+		return p, err
+	}
+
+	carry := uint64(binary.BigEndian.Uint32(b[:])%maxIncr) + 1
+
+	for i := len(p) - 1; i >= 0 && carry > 0; i-- {
+		sum := uint64(p[i]) + carry
+		p[i] = byte(sum)
+		carry = sum >> 8
+	}
+	if carry > 0 {
+		return p, fmt.Errorf("ksuid: monotonic payload overflowed, try again on the next tick")
+	}
+
+	return p, nil
+}
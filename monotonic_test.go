@@ -0,0 +1,81 @@
+package ksuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMonotonicGeneratorOrdersSameTick(t *testing.T) {
+	g := NewMonotonicGenerator()
+	now := time.Now()
+
+	var prev KSUID
+	for i := 0; i < 100; i++ {
+		id, err := g.next(now)
+		if err != nil {
+			t.Fatalf("next: %v", err)
+		}
+		if i > 0 && Compare(prev, id) >= 0 {
+			t.Fatalf("IDs not strictly increasing: %v then %v", prev, id)
+		}
+		prev = id
+	}
+}
+
+func TestMonotonicGeneratorMS(t *testing.T) {
+	g := NewMonotonicMS()
+	now := time.Now()
+
+	id, err := g.next(now)
+	if err != nil {
+		t.Fatalf("next: %v", err)
+	}
+
+	want := uint16(now.Nanosecond() / int(time.Millisecond))
+	got := uint16(id.Payload()[0])<<8 | uint16(id.Payload()[1])
+	if got != want {
+		t.Fatalf("millisecond payload = %v, want %v", got, want)
+	}
+}
+
+func TestMonotonicGeneratorMSOrdersUnderClockSkew(t *testing.T) {
+	g := NewMonotonicMS()
+
+	base := time.Now().Truncate(time.Second)
+	id1, err := g.next(base.Add(900 * time.Millisecond))
+	if err != nil {
+		t.Fatalf("next: %v", err)
+	}
+
+	// A second call in the previous wall-clock second, and at a smaller
+	// millisecond-within-second, must still sort after id1.
+	id2, err := g.next(base.Add(-time.Second).Add(100 * time.Millisecond))
+	if err != nil {
+		t.Fatalf("next: %v", err)
+	}
+
+	if Compare(id1, id2) >= 0 {
+		t.Fatalf("expected id1 < id2 despite backward clock skew, got Compare = %v", Compare(id1, id2))
+	}
+}
+
+func TestMonotonicGeneratorReset(t *testing.T) {
+	g := NewMonotonicGenerator()
+	now := time.Now()
+
+	first, err := g.next(now)
+	if err != nil {
+		t.Fatalf("next: %v", err)
+	}
+
+	g.Reset(now)
+
+	second, err := g.next(now)
+	if err != nil {
+		t.Fatalf("next: %v", err)
+	}
+
+	if first.Timestamp() != second.Timestamp() {
+		t.Fatalf("timestamps diverged after Reset: %v != %v", first.Timestamp(), second.Timestamp())
+	}
+}
@@ -0,0 +1,64 @@
+package ksuid
+
+import (
+	"crypto"
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+)
+
+// Predefined namespaces for use with FromName, analogous to the
+// well-known namespace UUIDs defined by RFC 4122.
+var (
+	NamespaceDNS = KSUID{
+		0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1,
+		0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	NamespaceURL = KSUID{
+		0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1,
+		0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	NamespaceOID = KSUID{
+		0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1,
+		0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8,
+		0x00, 0x00, 0x00, 0x00,
+	}
+)
+
+// FromName derives a deterministic KSUID from a namespace and a name,
+// analogous to RFC 4122's version 3 and 5 UUIDs: the same namespace and
+// name always produce the same KSUID. hash is computed over
+// namespace[:] followed by name; the first 4 bytes of the digest become
+// the timestamp field and the next 16 become the payload.
+//
+// FromName returns Nil if hash isn't linked into the binary or its
+// digest is shorter than a KSUID (20 bytes) — callers should stick to
+// FromNameSHA1 and FromNameSHA256, or blank-import and validate their
+// own hash.Hash before calling with anything else.
+func FromName(namespace KSUID, name []byte, hash crypto.Hash) KSUID {
+	if !hash.Available() || hash.Size() < byteLength {
+		return Nil
+	}
+
+	h := hash.New()
+	h.Write(namespace[:])
+	h.Write(name)
+	sum := h.Sum(nil)
+
+	var id KSUID
+	copy(id[:], sum[:byteLength])
+	return id
+}
+
+// FromNameSHA1 derives a deterministic KSUID from namespace and name
+// using SHA-1, analogous to an RFC 4122 version 5 UUID.
+func FromNameSHA1(namespace KSUID, name []byte) KSUID {
+	return FromName(namespace, name, crypto.SHA1)
+}
+
+// FromNameSHA256 derives a deterministic KSUID from namespace and name
+// using SHA-256.
+func FromNameSHA256(namespace KSUID, name []byte) KSUID {
+	return FromName(namespace, name, crypto.SHA256)
+}
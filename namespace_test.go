@@ -0,0 +1,44 @@
+package ksuid
+
+import "testing"
+
+func TestFromNameIsStable(t *testing.T) {
+	a := FromNameSHA1(NamespaceDNS, []byte("example.com"))
+	b := FromNameSHA1(NamespaceDNS, []byte("example.com"))
+
+	if a != b {
+		t.Fatalf("FromNameSHA1 not stable: %v != %v", a, b)
+	}
+}
+
+func TestFromNameDifferentHashesDiffer(t *testing.T) {
+	sha1ID := FromNameSHA1(NamespaceDNS, []byte("example.com"))
+	sha256ID := FromNameSHA256(NamespaceDNS, []byte("example.com"))
+
+	if sha1ID == sha256ID {
+		t.Fatalf("FromNameSHA1 and FromNameSHA256 produced the same ID")
+	}
+
+	if sha1ID.IsNil() || sha256ID.IsNil() {
+		t.Fatalf("expected non-nil IDs, got %v and %v", sha1ID, sha256ID)
+	}
+}
+
+func TestFromNameDifferentNamesDiffer(t *testing.T) {
+	a := FromNameSHA1(NamespaceDNS, []byte("example.com"))
+	b := FromNameSHA1(NamespaceDNS, []byte("example.org"))
+
+	if a == b {
+		t.Fatalf("expected different names to produce different IDs")
+	}
+}
+
+func TestFromNameUnavailableHash(t *testing.T) {
+	// crypto.Hash(0) is not a registered hash, so it should report
+	// unavailable rather than panic.
+	id := FromName(NamespaceDNS, []byte("example.com"), 0)
+
+	if !id.IsNil() {
+		t.Fatalf("expected Nil for an unregistered hash, got %v", id)
+	}
+}
@@ -0,0 +1,88 @@
+package ksuid
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// NullKSUID represents a KSUID that may be null, mirroring
+// database/sql.NullString.
+type NullKSUID struct {
+	KSUID KSUID
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullKSUID) Scan(src interface{}) error {
+	if src == nil {
+		n.KSUID, n.Valid = Nil, false
+		return nil
+	}
+	if err := n.KSUID.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface, returning nil rather
+// than the string form of Nil when the KSUID is invalid so that
+// nullable columns round-trip correctly.
+func (n NullKSUID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.KSUID.Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface, emitting null
+// for an invalid KSUID and a base62 string otherwise.
+func (n NullKSUID) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.KSUID.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *NullKSUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.KSUID, n.Valid = Nil, false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	id, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	n.KSUID, n.Valid = id, true
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (n NullKSUID) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.KSUID.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (n *NullKSUID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.KSUID, n.Valid = Nil, false
+		return nil
+	}
+
+	if err := n.KSUID.UnmarshalText(text); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,69 @@
+package ksuid
+
+import "testing"
+
+func TestNullKSUIDValueInvalid(t *testing.T) {
+	var n NullKSUID
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatalf("Value: %v", err)
+	}
+	if v != nil {
+		t.Fatalf("Value for invalid NullKSUID = %v, want nil", v)
+	}
+}
+
+func TestNullKSUIDScanRoundTrip(t *testing.T) {
+	id := New()
+
+	var n NullKSUID
+	if err := n.Scan(id.String()); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if !n.Valid || n.KSUID != id {
+		t.Fatalf("Scan = %v, %v, want %v, true", n.KSUID, n.Valid, id)
+	}
+
+	if err := n.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil): %v", err)
+	}
+	if n.Valid {
+		t.Fatalf("Scan(nil) left Valid = true")
+	}
+}
+
+func TestNullKSUIDJSONRoundTrip(t *testing.T) {
+	id := New()
+	valid := NullKSUID{KSUID: id, Valid: true}
+
+	b, err := valid.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	var decoded NullKSUID
+	if err := decoded.UnmarshalJSON(b); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if decoded != valid {
+		t.Fatalf("round trip = %+v, want %+v", decoded, valid)
+	}
+
+	var invalid NullKSUID
+	b, err = invalid.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if string(b) != "null" {
+		t.Fatalf("MarshalJSON for invalid = %q, want null", b)
+	}
+
+	var decodedInvalid NullKSUID
+	if err := decodedInvalid.UnmarshalJSON(b); err != nil {
+		t.Fatalf("UnmarshalJSON(null): %v", err)
+	}
+	if decodedInvalid.Valid {
+		t.Fatalf("UnmarshalJSON(null) left Valid = true")
+	}
+}